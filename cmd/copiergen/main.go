@@ -0,0 +1,210 @@
+// Command copiergen generates a specialized, reflection-free copy function
+// between two struct types declared in the current package, for use from a
+// go:generate directive such as:
+//
+//	//go:generate go run github.com/wencan/copier/cmd/copiergen -from=UserDTO -to=User
+//
+// The generated function assigns matching fields directly and falls back to
+// copier.Copy for any field whose type doesn't match exactly (e.g. nested
+// structs, or a destination field typed interface{}). Field matching calls
+// copier.ParseFieldTag, the same tag parser Copy itself uses, so the static
+// directives ("-"/"skip", a plain name override) behave identically here.
+// Generated assignments always overwrite the destination, which is the
+// same as "force" in the reflective Copier, so there's nothing further to
+// do for that directive here. A field tagged `context=...` or with a
+// dotted path needs information only available at Copy/CopyWithContext
+// time, so the generator omits it from the generated assignments and
+// prints a warning to stderr rather than silently dropping it; call
+// copier.Copy or copier.CopyWithContext for that field separately.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/wencan/copier"
+)
+
+func main() {
+	from := flag.String("from", "", "source struct type name")
+	to := flag.String("to", "", "destination struct type name")
+	out := flag.String("out", "", "output file name (default: <to>_copier.go, lowercased)")
+	flag.Parse()
+
+	if *from == "" || *to == "" {
+		log.Fatal("copiergen: both -from and -to are required")
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Fatalf("copiergen: %v", err)
+	}
+
+	pkgName, fromFields, err := findStruct(dir, *from)
+	if err != nil {
+		log.Fatalf("copiergen: %v", err)
+	}
+	_, toFields, err := findStruct(dir, *to)
+	if err != nil {
+		log.Fatalf("copiergen: %v", err)
+	}
+
+	src, err := generate(pkgName, *from, *to, fromFields, toFields)
+	if err != nil {
+		log.Fatalf("copiergen: %v", err)
+	}
+
+	outPath := *out
+	if outPath == "" {
+		outPath = fmt.Sprintf("%s_copier.go", strings.ToLower(*to))
+	}
+	if err := os.WriteFile(filepath.Join(dir, outPath), src, 0o644); err != nil {
+		log.Fatalf("copiergen: %v", err)
+	}
+}
+
+// genField is a single exported struct field as seen by the generator: its
+// real Go name, its source-level type expression, and the directives
+// pulled out of its copier tag.
+type genField struct {
+	GoName string
+	Type   string
+	Key    string
+	Skip   bool
+	// Dynamic means the tag used context=... or a dotted path: the
+	// generator can't resolve either statically, so the field is omitted
+	// from the generated function rather than matched.
+	Dynamic bool
+}
+
+// findStruct parses every non-test .go file in dir looking for a struct
+// type declaration named typeName, returning the package name and its
+// exported fields.
+func findStruct(dir, typeName string) (pkgName string, fields []genField, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, parser.ParseComments)
+		if err != nil {
+			return "", nil, err
+		}
+		if pkgName == "" {
+			pkgName = file.Name.Name
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || typeSpec.Name.Name != typeName {
+					continue
+				}
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					return "", nil, fmt.Errorf("type %s is not a struct", typeName)
+				}
+				return pkgName, structFields(fset, structType), nil
+			}
+		}
+	}
+	return "", nil, fmt.Errorf("type %s not found in %s", typeName, dir)
+}
+
+func structFields(fset *token.FileSet, structType *ast.StructType) []genField {
+	var fields []genField
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			// anonymous/embedded field: left for copier.Copy to handle
+			continue
+		}
+
+		typeStr := exprString(fset, field.Type)
+		tag := ""
+		if field.Tag != nil {
+			tag = strings.Trim(field.Tag.Value, "`")
+		}
+
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+			structField := reflect.StructField{Name: name.Name, Tag: reflect.StructTag(tag)}
+			key, skip, _, context, path := copier.ParseFieldTag("copier", structField)
+			dynamic := context != "" || len(path) > 0
+			fields = append(fields, genField{GoName: name.Name, Type: typeStr, Key: key, Skip: skip, Dynamic: dynamic})
+		}
+	}
+	return fields
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, expr)
+	return buf.String()
+}
+
+// generate emits a Copy<From>To<To> function that assigns matching fields
+// directly, falling back to copier.Copy for any field whose type doesn't
+// match exactly between the two structs.
+func generate(pkgName, fromType, toType string, fromFields, toFields []genField) ([]byte, error) {
+	toByKey := make(map[string]genField, len(toFields))
+	for _, f := range toFields {
+		toByKey[f.Key] = f
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// Code generated by copiergen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&body, "package %s\n\n", pkgName)
+	fmt.Fprintf(&body, "import \"github.com/wencan/copier\"\n\n")
+	fmt.Fprintf(&body, "// Copy%sTo%s copies src into dst without reflection, falling back to\n", fromType, toType)
+	fmt.Fprintf(&body, "// copier.Copy for any field whose type doesn't match exactly.\n")
+	fmt.Fprintf(&body, "func Copy%sTo%s(dst *%s, src *%s) error {\n", fromType, toType, toType, fromType)
+	for _, f := range fromFields {
+		if f.Skip {
+			continue
+		}
+		if f.Dynamic {
+			fmt.Fprintf(os.Stderr, "copiergen: warning: %s.%s uses a context= or dotted-path copier tag, which needs runtime information; Copy%sTo%s does not set it, call copier.Copy or copier.CopyWithContext separately for this field\n", fromType, f.GoName, fromType, toType)
+			continue
+		}
+		toField, ok := toByKey[f.Key]
+		if !ok || toField.Skip || toField.Dynamic {
+			continue
+		}
+		if toField.Type == f.Type {
+			fmt.Fprintf(&body, "\tdst.%s = src.%s\n", toField.GoName, f.GoName)
+		} else {
+			fmt.Fprintf(&body, "\tif err := copier.Copy(&dst.%s, src.%s); err != nil {\n\t\treturn err\n\t}\n", toField.GoName, f.GoName)
+		}
+	}
+	for _, f := range toFields {
+		if f.Dynamic {
+			fmt.Fprintf(os.Stderr, "copiergen: warning: %s.%s uses a context= or dotted-path copier tag, which needs runtime information; Copy%sTo%s does not set it, call copier.Copy or copier.CopyWithContext separately for this field\n", toType, f.GoName, fromType, toType)
+		}
+	}
+	fmt.Fprintf(&body, "\treturn nil\n}\n")
+
+	return format.Source(body.Bytes())
+}
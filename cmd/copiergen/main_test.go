@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+)
+
+// structFieldsFromSource parses src and returns the fields of the struct
+// type named typeName, the same way findStruct does for a file on disk.
+func structFieldsFromSource(t *testing.T, src, typeName string) []genField {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "src.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok || typeSpec.Name.Name != typeName {
+				continue
+			}
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				t.Fatalf("type %s is not a struct", typeName)
+			}
+			return structFields(fset, structType)
+		}
+	}
+	t.Fatalf("type %s not found", typeName)
+	return nil
+}
+
+func TestGenerate(t *testing.T) {
+	fromFields := []genField{
+		{GoName: "Name", Type: "string", Key: "Name"},
+		{GoName: "Password", Type: "string", Key: "Password", Skip: true},
+		{GoName: "Nickname", Type: "string", Key: "alias"},
+		{GoName: "Profile", Type: "Profile", Key: "Profile"},
+		{GoName: "TenantID", Type: "string", Key: "TenantID", Dynamic: true},
+	}
+	toFields := []genField{
+		{GoName: "Name", Type: "string", Key: "Name"},
+		{GoName: "Alias", Type: "string", Key: "alias"},
+		{GoName: "Profile", Type: "*Profile", Key: "Profile"},
+	}
+
+	src, err := generate("demo", "Src", "Dst", fromFields, toFields)
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	out := string(src)
+
+	if !strings.Contains(out, "dst.Name = src.Name") {
+		t.Errorf("missing direct assignment for matching type, got:\n%s", out)
+	}
+	if !strings.Contains(out, "dst.Alias = src.Nickname") {
+		t.Errorf("missing renamed-key assignment, got:\n%s", out)
+	}
+	if !strings.Contains(out, "copier.Copy(&dst.Profile, src.Profile)") {
+		t.Errorf("missing copier.Copy fallback for mismatched types, got:\n%s", out)
+	}
+	if strings.Contains(out, "Password") {
+		t.Errorf("skipped field Password must not appear in generated code, got:\n%s", out)
+	}
+	if strings.Contains(out, "TenantID") {
+		t.Errorf("dynamic (context/dotted-path) field TenantID must not appear in generated code, got:\n%s", out)
+	}
+}
+
+func TestGenerateWarnsAboutDynamicFields(t *testing.T) {
+	fromFields := []genField{
+		{GoName: "TenantID", Type: "string", Key: "TenantID", Dynamic: true},
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() error = %v", err)
+	}
+	origStderr := os.Stderr
+	os.Stderr = w
+	_, genErr := generate("demo", "Src", "Dst", fromFields, nil)
+	w.Close()
+	os.Stderr = origStderr
+	if genErr != nil {
+		t.Fatalf("generate() error = %v", genErr)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	if !strings.Contains(buf.String(), "TenantID") || !strings.Contains(buf.String(), "context=") {
+		t.Errorf("expected a warning naming the dynamic field and its tag kind, got: %q", buf.String())
+	}
+}
+
+func TestParseFieldTagViaCopierSkipAndDynamic(t *testing.T) {
+	fields := structFieldsFromSource(t, `
+		package demo
+
+		type Src struct {
+			Name     string
+			Password string ` + "`copier:\"-\"`" + `
+			Nickname string ` + "`copier:\"alias\"`" + `
+			TenantID string ` + "`copier:\"context=tenant_id\"`" + `
+			Deep     string ` + "`copier:\"user.profile.name\"`" + `
+		}
+	`, "Src")
+
+	want := map[string]genField{
+		"Name":     {GoName: "Name", Type: "string", Key: "Name"},
+		"Password": {GoName: "Password", Type: "string", Skip: true},
+		"Nickname": {GoName: "Nickname", Type: "string", Key: "alias"},
+		"TenantID": {GoName: "TenantID", Type: "string", Key: "TenantID", Dynamic: true},
+		"Deep":     {GoName: "Deep", Type: "string", Key: "user.profile.name", Dynamic: true},
+	}
+
+	if len(fields) != len(want) {
+		t.Fatalf("got %d fields, want %d: %+v", len(fields), len(want), fields)
+	}
+	for _, f := range fields {
+		w, ok := want[f.GoName]
+		if !ok {
+			t.Errorf("unexpected field %+v", f)
+			continue
+		}
+		if f != w {
+			t.Errorf("field %s = %+v, want %+v", f.GoName, f, w)
+		}
+	}
+}
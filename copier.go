@@ -3,21 +3,66 @@ package copier
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 )
 
-// defaultCopier copier without tag key
-var defaultCopier = NewCopier("", "")
+// defaultCopier is the copier behind the package-level Copy/CopyWithContext
+// functions. It uses "copier" as both tag keys so that skip/force/skipzero
+// and context tags are honored by those entry points, not just by copiers
+// built via NewCopier.
+var defaultCopier = NewCopier("copier", "copier")
 
 // Copy copy things
 func Copy(toValue interface{}, fromValue interface{}) (err error) {
 	return defaultCopier.Copy(toValue, fromValue)
 }
 
+// CopyWithContext copy things, resolving any field tagged `copier:"context=key"`
+// from ctx instead of from a matching source field.
+func CopyWithContext(toValue interface{}, fromValue interface{}, ctx map[string]interface{}) (err error) {
+	return defaultCopier.CopyWithContext(toValue, fromValue, ctx)
+}
+
+// DeepCopy copies things, deep-cloning slices, maps, and pointer graphs
+// instead of sharing their backing storage with the source.
+func DeepCopy(toValue interface{}, fromValue interface{}) (err error) {
+	return NewCopier("copier", "copier").WithDeep(true).Copy(toValue, fromValue)
+}
+
 // Copier copier with tag
 type Copier struct {
 	toTagKey, fromTagKey string
+	deep                 bool
+	converters           map[converterKey]ConverterFunc
+}
+
+// ConverterFunc converts a value of a registered source type into a value
+// of a registered destination type. An error returned here propagates up
+// through Copy.
+type ConverterFunc func(src interface{}) (interface{}, error)
+
+// Converter is implemented by destination types that want full control
+// over how a source value is converted into them, analogous to how
+// sql.Scanner is detected below.
+type Converter interface {
+	Convert(src interface{}) (interface{}, error)
+}
+
+type converterKey struct {
+	from, to reflect.Type
+}
+
+// RegisterConverter registers fn to convert values of type fromType into
+// values of type toType. The registry is consulted in set() before
+// falling back to ConvertibleTo/sql.Scanner, so it also overrides those
+// for the registered pair.
+func (copier *Copier) RegisterConverter(fromType, toType reflect.Type, fn ConverterFunc) {
+	if copier.converters == nil {
+		copier.converters = make(map[converterKey]ConverterFunc)
+	}
+	copier.converters[converterKey{from: fromType, to: toType}] = fn
 }
 
 // NewCopier new copier
@@ -30,6 +75,26 @@ func NewCopier(toTagKey, fromTagKey string) *Copier {
 
 // Copy copy things with tag key
 func (copier *Copier) Copy(toValue interface{}, fromValue interface{}) (err error) {
+	return copier.copy(toValue, fromValue, nil)
+}
+
+// CopyWithContext copy things with tag key, additionally resolving any
+// destination field whose toTagKey tag has the option context=key (e.g.
+// `copier:"context=key"` when toTagKey is "copier") from ctx[key].
+func (copier *Copier) CopyWithContext(toValue interface{}, fromValue interface{}, ctx map[string]interface{}) (err error) {
+	return copier.copy(toValue, fromValue, ctx)
+}
+
+// WithDeep toggles deep-copy mode and returns the copier for chaining. In
+// deep mode, slices, maps, arrays, and pointer targets are cloned into
+// freshly allocated backing storage instead of being shared with the
+// source, and pointer cycles are broken automatically.
+func (copier *Copier) WithDeep(deep bool) *Copier {
+	copier.deep = deep
+	return copier
+}
+
+func (copier *Copier) copy(toValue interface{}, fromValue interface{}, ctx map[string]interface{}) (err error) {
 	var (
 		isSlice bool
 		amount  = 1
@@ -52,11 +117,30 @@ func (copier *Copier) Copy(toValue interface{}, fromValue interface{}) (err erro
 	// Just set it if possible to assign
 	// And need to do copy anyway if the type is struct
 	if fromType.Kind() != reflect.Struct && from.Type().AssignableTo(to.Type()) {
-		to.Set(from)
+		if copier.deep {
+			to.Set(deepClone(from, make(map[seenKey]reflect.Value)))
+		} else {
+			to.Set(from)
+		}
 		return
 	}
 
-	if fromType.Kind() != reflect.Struct || toType.Kind() != reflect.Struct {
+	// from/to themselves (not indirectType, which also peels off Slice) must
+	// be the map/struct directly here; a []map[string]any or []SomeStruct
+	// needs to fall through to the per-element slice loop below instead.
+	if from.Kind() != reflect.Slice && to.Kind() != reflect.Slice {
+		if fromType.Kind() == reflect.Map && toType.Kind() == reflect.Struct {
+			return copier.copyMapToStruct(to, from, ctx)
+		}
+		if fromType.Kind() == reflect.Struct && toType.Kind() == reflect.Map {
+			return copier.copyStructToMap(to, from)
+		}
+	}
+
+	isMapToStruct := fromType.Kind() == reflect.Map && toType.Kind() == reflect.Struct
+	isStructToMap := fromType.Kind() == reflect.Struct && toType.Kind() == reflect.Map
+	isStructToStruct := fromType.Kind() == reflect.Struct && toType.Kind() == reflect.Struct
+	if !isMapToStruct && !isStructToMap && !isStructToStruct {
 		return
 	}
 
@@ -85,7 +169,15 @@ func (copier *Copier) Copy(toValue interface{}, fromValue interface{}) (err erro
 		}
 
 		// check source
-		if source.IsValid() {
+		if source.IsValid() && isMapToStruct {
+			if err := copier.copyMapToStruct(dest, source, ctx); err != nil {
+				return err
+			}
+		} else if source.IsValid() && isStructToMap {
+			if err := copier.copyStructToMap(dest, source); err != nil {
+				return err
+			}
+		} else if source.IsValid() {
 			fromTypeFields := deepFields(copier.fromTagKey, fromType)
 			// log.Println(fromTypeFields)
 			toTypeFields := deepFields(copier.toTagKey, toType)
@@ -93,13 +185,28 @@ func (copier *Copier) Copy(toValue interface{}, fromValue interface{}) (err erro
 
 			// Copy from field to field or method
 			for name, field := range fromTypeFields {
+				if len(field.Path) > 0 {
+					// tag named a dotted path into dest; handled below
+					continue
+				}
 				if fromField := source.FieldByName(field.Name); fromField.IsValid() {
 					// has field
 					if toTypeField, exist := toTypeFields[name]; exist {
+						if toTypeField.Context != "" {
+							// bound to a context value instead of a source field
+							continue
+						}
 						if toField := dest.FieldByName(toTypeField.Name); toField.IsValid() {
 							if toField.CanSet() {
-								if !set(toField, fromField) {
-									if err := copier.Copy(toField.Addr().Interface(), fromField.Interface()); err != nil {
+								if toTypeField.SkipZero && !toTypeField.Force && isZero(fromField) && !isZero(toField) {
+									continue
+								}
+								ok, err := copier.set(toField, fromField)
+								if err != nil {
+									return err
+								}
+								if !ok {
+									if err := copier.copy(toField.Addr().Interface(), fromField.Interface(), ctx); err != nil {
 										return err
 									}
 								}
@@ -124,6 +231,10 @@ func (copier *Copier) Copy(toValue interface{}, fromValue interface{}) (err erro
 
 			// Copy from method to field
 			for name, toTypefield := range toTypeFields {
+				if toTypefield.Context != "" || len(toTypefield.Path) > 0 {
+					continue
+				}
+
 				var fromMethod reflect.Value
 				if source.CanAddr() {
 					fromMethod = source.Addr().MethodByName(name)
@@ -135,11 +246,75 @@ func (copier *Copier) Copy(toValue interface{}, fromValue interface{}) (err erro
 					if toField := dest.FieldByName(toTypefield.Name); toField.IsValid() && toField.CanSet() {
 						values := fromMethod.Call([]reflect.Value{})
 						if len(values) >= 1 {
-							set(toField, values[0])
+							if toTypefield.Force || !toTypefield.SkipZero || !isZero(values[0]) || isZero(toField) {
+								if _, err := copier.set(toField, values[0]); err != nil {
+									return err
+								}
+							}
+						}
+					}
+				}
+			}
+
+			// Copy from ctx to any field bound via `copier:"context=key"`
+			if ctx != nil {
+				for _, toTypefield := range toTypeFields {
+					if toTypefield.Context == "" {
+						continue
+					}
+					value, exist := ctx[toTypefield.Context]
+					if !exist {
+						continue
+					}
+					if toField := dest.FieldByName(toTypefield.Name); toField.IsValid() && toField.CanSet() {
+						if _, err := copier.set(toField, reflect.ValueOf(value)); err != nil {
+							return err
 						}
 					}
 				}
 			}
+
+			// Copy fields tagged with a dotted path into a nested dest field,
+			// auto-allocating any nil intermediate pointers/structs.
+			for _, field := range fromTypeFields {
+				if len(field.Path) == 0 {
+					continue
+				}
+				fromField := source.FieldByName(field.Name)
+				if !fromField.IsValid() {
+					continue
+				}
+				toField, err := fieldByPathAlloc(dest, field.Path)
+				if err != nil {
+					return err
+				}
+				if toField.CanSet() {
+					if _, err := copier.set(toField, fromField); err != nil {
+						return err
+					}
+				}
+			}
+
+			// Copy fields tagged with a dotted path into a nested source field
+			// (flattening a nested source into a flat destination field).
+			for _, toTypefield := range toTypeFields {
+				if len(toTypefield.Path) == 0 {
+					continue
+				}
+				toField := dest.FieldByName(toTypefield.Name)
+				if !toField.IsValid() || !toField.CanSet() {
+					continue
+				}
+				fromField, err := fieldByPath(source, toTypefield.Path)
+				if err != nil {
+					return err
+				}
+				if fromField.IsValid() {
+					if _, err := copier.set(toField, fromField); err != nil {
+						return err
+					}
+				}
+			}
 		}
 		if isSlice {
 			if dest.Addr().Type().AssignableTo(to.Type().Elem()) {
@@ -152,8 +327,112 @@ func (copier *Copier) Copy(toValue interface{}, fromValue interface{}) (err erro
 	return
 }
 
-func deepFields(tagKey string, reflectType reflect.Type) map[string]reflect.StructField {
-	fields := make(map[string]reflect.StructField)
+// copyMapToStruct copies a string-keyed map into a struct, matching map
+// keys against destination field names (resolved through toTagKey) and
+// recursing into copy for nested structs/maps.
+func (copier *Copier) copyMapToStruct(dest reflect.Value, from reflect.Value, ctx map[string]interface{}) error {
+	if from.Kind() != reflect.Map || from.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+
+	toTypeFields := deepFields(copier.toTagKey, dest.Type())
+
+	iter := from.MapRange()
+	for iter.Next() {
+		toTypeField, exist := toTypeFields[iter.Key().String()]
+		if !exist || toTypeField.Context != "" {
+			continue
+		}
+		toField := dest.FieldByName(toTypeField.Name)
+		if !toField.IsValid() || !toField.CanSet() {
+			continue
+		}
+
+		value := iter.Value()
+		if value.Kind() == reflect.Interface {
+			value = value.Elem()
+		}
+		if !value.IsValid() {
+			continue
+		}
+
+		ok, err := copier.set(toField, value)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if err := copier.copy(toField.Addr().Interface(), value.Interface(), ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// copyStructToMap copies a struct into a string-keyed map, keying each
+// entry by the source field's name as resolved through fromTagKey.
+func (copier *Copier) copyStructToMap(dest reflect.Value, from reflect.Value) error {
+	if dest.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+	if dest.IsNil() {
+		dest.Set(reflect.MakeMap(dest.Type()))
+	}
+
+	fromTypeFields := deepFields(copier.fromTagKey, from.Type())
+	for name, field := range fromTypeFields {
+		if len(field.Path) > 0 {
+			continue
+		}
+		fromField := from.FieldByName(field.Name)
+		if !fromField.IsValid() {
+			continue
+		}
+
+		elem := reflect.New(dest.Type().Elem()).Elem()
+		if ok, err := copier.set(elem, fromField); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		dest.SetMapIndex(reflect.ValueOf(name), elem)
+	}
+	return nil
+}
+
+// fieldOptions carries the per-field directives pulled out of a struct tag,
+// alongside the resolved name.
+type fieldOptions struct {
+	// Skip means the field must never be copied, in either direction.
+	Skip bool
+	// Force means the field must always be overwritten, even when the
+	// source value is the zero value for its type.
+	Force bool
+	// SkipZero means a zero-valued source must not overwrite a non-zero
+	// destination. Overwriting is the default; this is opt-in via the
+	// "skipzero" tag option and is itself overridden by Force.
+	SkipZero bool
+	// Context, when non-empty, means the field is populated from the ctx
+	// map passed to CopyWithContext under this key, instead of being
+	// matched against a source field.
+	Context string
+	// Path, when non-empty, means the tag named a dotted path (e.g.
+	// "User.Profile.Name") into the *other* struct rather than a plain
+	// field name: a source field with a Path writes into that nested
+	// destination path, and a destination field with a Path reads from
+	// that nested source path.
+	Path []string
+}
+
+// fieldDescriptor is a struct field together with the tag options that were
+// resolved for it.
+type fieldDescriptor struct {
+	reflect.StructField
+	fieldOptions
+}
+
+func deepFields(tagKey string, reflectType reflect.Type) map[string]fieldDescriptor {
+	fields := make(map[string]fieldDescriptor)
 
 	if reflectType = indirectType(reflectType); reflectType.Kind() == reflect.Struct {
 		for i := 0; i < reflectType.NumField(); i++ {
@@ -166,11 +445,11 @@ func deepFields(tagKey string, reflectType reflect.Type) map[string]reflect.Stru
 					}
 				}
 			} else {
-				name, skip := fieldName(tagKey, v)
-				if skip {
+				name, opts := fieldName(tagKey, v)
+				if opts.Skip {
 					continue
 				}
-				fields[name] = v
+				fields[name] = fieldDescriptor{StructField: v, fieldOptions: opts}
 			}
 		}
 	}
@@ -178,7 +457,7 @@ func deepFields(tagKey string, reflectType reflect.Type) map[string]reflect.Stru
 	return fields
 }
 
-func fieldName(tagKey string, field reflect.StructField) (name string, skip bool) {
+func fieldName(tagKey string, field reflect.StructField) (name string, opts fieldOptions) {
 	tagParts := strings.Split(tagKey, ".")
 	tagKey = tagParts[0]
 	tagField := ""
@@ -188,29 +467,116 @@ func fieldName(tagKey string, field reflect.StructField) (name string, skip bool
 
 	if tagKey == "" {
 		name = field.Name
-	} else {
-		key := field.Tag.Get(tagKey)
-		key = strings.ReplaceAll(key, " ", "")
-		if key == "" {
-			name = field.Name
-		} else if key == "-" {
-			skip = true
-		} else if tagField == "" {
-			keys := strings.Split(key, ",")
-			name = keys[0]
-		} else {
-			keys := strings.Split(key, ",")
-			for _, key := range keys {
-				pair := strings.Split(key, "=")
-				if len(pair) == 2 && pair[0] == tagField {
-					name = pair[1]
-				}
+		return
+	}
+
+	key := field.Tag.Get(tagKey)
+	key = strings.ReplaceAll(key, " ", "")
+	if key == "" {
+		name = field.Name
+		return
+	} else if key == "-" {
+		opts.Skip = true
+		return
+	}
+
+	keys := strings.Split(key, ",")
+	for i, key := range keys {
+		switch {
+		case key == "skip":
+			opts.Skip = true
+		case key == "force":
+			opts.Force = true
+		case key == "skipzero":
+			opts.SkipZero = true
+		case strings.HasPrefix(key, "context="):
+			opts.Context = strings.TrimPrefix(key, "context=")
+		case tagField != "":
+			if pair := strings.SplitN(key, "=", 2); len(pair) == 2 && pair[0] == tagField {
+				name = pair[1]
 			}
+		case i == 0:
+			name = key
 		}
 	}
+
+	if name == "" && !opts.Skip {
+		name = field.Name
+	}
+	if strings.Contains(name, ".") {
+		opts.Path = strings.Split(name, ".")
+	}
 	return
 }
 
+// ParseFieldTag parses field's tagKey struct tag the same way Copy does,
+// returning the resolved match name alongside its directives. It's exported
+// so tools built on this package, such as cmd/copiergen, can share the
+// exact tag grammar instead of drifting from it with a reimplementation.
+func ParseFieldTag(tagKey string, field reflect.StructField) (name string, skip, force bool, context string, path []string) {
+	name, opts := fieldName(tagKey, field)
+	return name, opts.Skip, opts.Force, opts.Context, opts.Path
+}
+
+// fieldByName looks up name on a struct value case insensitively, unlike
+// the exact name matching used elsewhere in the package, since a
+// dotted-path tag is free-form text (e.g. "user.profile.name") while the
+// fields it names are exported Go identifiers (User.Profile.Name).
+func fieldByName(value reflect.Value, name string) reflect.Value {
+	return value.FieldByNameFunc(func(fieldName string) bool {
+		return strings.EqualFold(fieldName, name)
+	})
+}
+
+// fieldByPath reads the field at the end of path (dot-separated, matched
+// case insensitively against Go field names), walking through any
+// pointers and anonymous structs it meets along the way without
+// allocating. A nil pointer along the way means the nested data simply
+// isn't there, so it returns the zero Value with no error; a path segment
+// that doesn't name a field at all is a misconfigured tag and is reported
+// as an error instead of silently dropping the write.
+func fieldByPath(value reflect.Value, path []string) (reflect.Value, error) {
+	for _, name := range path {
+		value = indirect(value)
+		if !value.IsValid() {
+			return reflect.Value{}, nil
+		}
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("copier: path %q: %q is not a struct", strings.Join(path, "."), name)
+		}
+		value = fieldByName(value, name)
+		if !value.IsValid() {
+			return reflect.Value{}, fmt.Errorf("copier: path %q: field %q not found", strings.Join(path, "."), name)
+		}
+	}
+	return value, nil
+}
+
+// fieldByPathAlloc is like fieldByPath but allocates nil intermediate
+// pointers it meets along the way, so the field at the end of path can be
+// set on an otherwise zero-valued destination.
+func fieldByPathAlloc(value reflect.Value, path []string) (reflect.Value, error) {
+	for _, name := range path {
+		for value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				if !value.CanSet() {
+					return reflect.Value{}, fmt.Errorf("copier: path %q: %q is unaddressable", strings.Join(path, "."), name)
+				}
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("copier: path %q: %q is not a struct", strings.Join(path, "."), name)
+		}
+		value = fieldByName(value, name)
+		if !value.IsValid() {
+			return reflect.Value{}, fmt.Errorf("copier: path %q: field %q not found", strings.Join(path, "."), name)
+		}
+	}
+	return value, nil
+}
+
 func indirect(reflectValue reflect.Value) reflect.Value {
 	for reflectValue.Kind() == reflect.Ptr {
 		reflectValue = reflectValue.Elem()
@@ -225,6 +591,155 @@ func indirectType(reflectType reflect.Type) reflect.Type {
 	return reflectType
 }
 
+func isZero(value reflect.Value) bool {
+	if !value.IsValid() {
+		return true
+	}
+	return value.IsZero()
+}
+
+// set copies from into to. It deep-clones slices, maps, arrays, and
+// pointer targets instead of sharing their backing storage when the
+// copier is in deep-copy mode, consults any registered converters (by
+// type pair, then by the destination's Convert method) before falling
+// back to plain conversion, and propagates converter errors to the
+// caller instead of silently reporting failure.
+func (copier *Copier) set(to, from reflect.Value) (bool, error) {
+	if copier.deep && from.IsValid() {
+		switch from.Kind() {
+		case reflect.Slice, reflect.Map, reflect.Array, reflect.Ptr, reflect.Interface:
+			if from.Type().AssignableTo(to.Type()) {
+				to.Set(deepClone(from, make(map[seenKey]reflect.Value)))
+				return true, nil
+			}
+		}
+	}
+
+	if from.IsValid() && copier.converters != nil {
+		if fn, ok := copier.converters[converterKey{from: from.Type(), to: to.Type()}]; ok {
+			result, err := fn(from.Interface())
+			if err != nil {
+				return false, err
+			}
+			resultValue := reflect.ValueOf(result)
+			if resultValue.IsValid() && resultValue.Type().AssignableTo(to.Type()) {
+				to.Set(resultValue)
+				return true, nil
+			}
+			return false, nil
+		}
+	}
+
+	if from.IsValid() && to.CanAddr() {
+		if converter, ok := to.Addr().Interface().(Converter); ok {
+			result, err := converter.Convert(from.Interface())
+			if err != nil {
+				return false, err
+			}
+			resultValue := reflect.ValueOf(result)
+			if resultValue.IsValid() && resultValue.Type().AssignableTo(to.Type()) {
+				to.Set(resultValue)
+				return true, nil
+			}
+			return false, nil
+		}
+	}
+
+	return set(to, from), nil
+}
+
+// seenKey identifies an already-cloned pointer/slice/map by both its
+// runtime pointer and its type: Go's allocator hands out the same address
+// (e.g. zerobase) to distinct zero-size values of different types, so the
+// pointer alone isn't a safe cache key.
+type seenKey struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+// deepClone recursively clones value into a new, independently addressable
+// value of the same type: fresh backing arrays for slices, fresh buckets
+// for maps, and fresh targets for pointers. seen tracks already-cloned
+// pointers/slices/maps (keyed by type and runtime pointer) so that cycles
+// in the source graph are broken instead of recursing forever.
+func deepClone(value reflect.Value, seen map[seenKey]reflect.Value) reflect.Value {
+	if !value.IsValid() {
+		return value
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return reflect.Zero(value.Type())
+		}
+		key := seenKey{typ: value.Type(), ptr: value.Pointer()}
+		if cloned, ok := seen[key]; ok {
+			return cloned
+		}
+		cloned := reflect.New(value.Type().Elem())
+		seen[key] = cloned
+		cloned.Elem().Set(deepClone(value.Elem(), seen))
+		return cloned
+	case reflect.Slice:
+		if value.IsNil() {
+			return reflect.Zero(value.Type())
+		}
+		key := seenKey{typ: value.Type(), ptr: value.Pointer()}
+		if cloned, ok := seen[key]; ok {
+			return cloned
+		}
+		cloned := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		seen[key] = cloned
+		for i := 0; i < value.Len(); i++ {
+			cloned.Index(i).Set(deepClone(value.Index(i), seen))
+		}
+		return cloned
+	case reflect.Array:
+		cloned := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			cloned.Index(i).Set(deepClone(value.Index(i), seen))
+		}
+		return cloned
+	case reflect.Map:
+		if value.IsNil() {
+			return reflect.Zero(value.Type())
+		}
+		key := seenKey{typ: value.Type(), ptr: value.Pointer()}
+		if cloned, ok := seen[key]; ok {
+			return cloned
+		}
+		cloned := reflect.MakeMapWithSize(value.Type(), value.Len())
+		seen[key] = cloned
+		iter := value.MapRange()
+		for iter.Next() {
+			cloned.SetMapIndex(deepClone(iter.Key(), seen), deepClone(iter.Value(), seen))
+		}
+		return cloned
+	case reflect.Struct:
+		cloned := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.NumField(); i++ {
+			field := cloned.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			field.Set(deepClone(value.Field(i), seen))
+		}
+		return cloned
+	case reflect.Interface:
+		// The dynamic value boxed in the interface (e.g. a []int stored in
+		// a map[string]interface{}) is what actually owns backing storage,
+		// so clone it and rebox it rather than falling through to default.
+		if value.IsNil() {
+			return reflect.Zero(value.Type())
+		}
+		cloned := reflect.New(value.Type()).Elem()
+		cloned.Set(deepClone(value.Elem(), seen))
+		return cloned
+	default:
+		return value
+	}
+}
+
 func set(to, from reflect.Value) bool {
 	if from.IsValid() {
 		if to.Kind() == reflect.Ptr {
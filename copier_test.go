@@ -0,0 +1,284 @@
+package copier
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCopyTagKeyOverwritesZeroByDefault(t *testing.T) {
+	type Src struct {
+		Status string `copier:"status"`
+	}
+	type Dst struct {
+		Status string `copier:"status"`
+	}
+
+	dst := &Dst{Status: "active"}
+	if err := NewCopier("copier", "copier").Copy(dst, &Src{Status: ""}); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if dst.Status != "" {
+		t.Errorf("Status = %q, want overwritten to zero value", dst.Status)
+	}
+}
+
+func TestPackageLevelCopyWithContextHonorsContextTag(t *testing.T) {
+	type Src struct {
+		Name string
+	}
+	type Dst struct {
+		Name     string
+		TenantID string `copier:"context=tenant_id"`
+	}
+
+	dst := &Dst{}
+	err := CopyWithContext(dst, &Src{Name: "hello"}, map[string]interface{}{"tenant_id": "T1"})
+	if err != nil {
+		t.Fatalf("CopyWithContext() error = %v", err)
+	}
+	if dst.Name != "hello" {
+		t.Errorf("Name = %q, want %q", dst.Name, "hello")
+	}
+	if dst.TenantID != "T1" {
+		t.Errorf("TenantID = %q, want %q", dst.TenantID, "T1")
+	}
+}
+
+func TestPackageLevelCopyHonorsSkipAndForceTags(t *testing.T) {
+	type Src struct {
+		Name     string
+		Secret   string `copier:"secret"`
+		Status   string `copier:"status"`
+		Computed string `copier:"computed"`
+	}
+	type Dst struct {
+		Name     string
+		Secret   string `copier:"-"`
+		Status   string `copier:"status,skipzero"`
+		Computed string `copier:"computed,skipzero,force"`
+	}
+
+	dst := &Dst{Status: "active", Computed: "stale"}
+	err := Copy(dst, &Src{Name: "hello", Secret: "hush", Status: "", Computed: ""})
+	if err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if dst.Name != "hello" {
+		t.Errorf("Name = %q, want %q", dst.Name, "hello")
+	}
+	if dst.Secret != "" {
+		t.Errorf("Secret = %q, want untouched by skip", dst.Secret)
+	}
+	if dst.Status != "active" {
+		t.Errorf("Status = %q, want unchanged by zero-valued source", dst.Status)
+	}
+	if dst.Computed != "" {
+		t.Errorf("Computed = %q, want overwritten because of force", dst.Computed)
+	}
+}
+
+func TestCopySkipZeroOptIn(t *testing.T) {
+	type Src struct {
+		Status string `copier:"status"`
+	}
+	type Dst struct {
+		Status string `copier:"status,skipzero"`
+	}
+
+	dst := &Dst{Status: "active"}
+	if err := NewCopier("copier", "copier").Copy(dst, &Src{Status: ""}); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if dst.Status != "active" {
+		t.Errorf("Status = %q, want unchanged by zero-valued source", dst.Status)
+	}
+}
+
+func TestCopySkipZeroOverriddenByForce(t *testing.T) {
+	type Src struct {
+		Status string `copier:"status"`
+	}
+	type Dst struct {
+		Status string `copier:"status,skipzero,force"`
+	}
+
+	dst := &Dst{Status: "active"}
+	if err := NewCopier("copier", "copier").Copy(dst, &Src{Status: ""}); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if dst.Status != "" {
+		t.Errorf("Status = %q, want overwritten because of force", dst.Status)
+	}
+}
+
+func TestDeepCopyInterfaceBoxedMapValue(t *testing.T) {
+	src := map[string]interface{}{"nums": []int{1, 2, 3}}
+	dst := map[string]interface{}{}
+	if err := DeepCopy(&dst, &src); err != nil {
+		t.Fatalf("DeepCopy() error = %v", err)
+	}
+
+	dstNums := dst["nums"].([]int)
+	dstNums[0] = 99
+	if srcNums := src["nums"].([]int); srcNums[0] != 1 {
+		t.Errorf("source mutated to %v, want backing array independent of dst", srcNums)
+	}
+}
+
+func TestDeepCopyInterfaceBoxedStructField(t *testing.T) {
+	type Holder struct {
+		Value interface{}
+	}
+
+	src := &Holder{Value: []int{1, 2, 3}}
+	dst := &Holder{}
+	if err := DeepCopy(dst, src); err != nil {
+		t.Fatalf("DeepCopy() error = %v", err)
+	}
+
+	dstNums := dst.Value.([]int)
+	dstNums[0] = 99
+	if srcNums := src.Value.([]int); srcNums[0] != 1 {
+		t.Errorf("source mutated to %v, want backing array independent of dst", srcNums)
+	}
+}
+
+func TestDeepCopySiblingEmptyFieldsDontCollide(t *testing.T) {
+	type Inner struct {
+		A []int
+		B []string
+	}
+	type Outer struct {
+		Inner *Inner
+	}
+
+	src := &Outer{Inner: &Inner{}}
+	dst := &Outer{}
+	if err := DeepCopy(dst, src); err != nil {
+		t.Fatalf("DeepCopy() error = %v", err)
+	}
+	if dst.Inner == nil {
+		t.Fatal("Inner = nil, want cloned Inner")
+	}
+	if reflect.TypeOf(dst.Inner.A) != reflect.TypeOf([]int(nil)) {
+		t.Errorf("A has type %T, want []int", dst.Inner.A)
+	}
+	if reflect.TypeOf(dst.Inner.B) != reflect.TypeOf([]string(nil)) {
+		t.Errorf("B has type %T, want []string", dst.Inner.B)
+	}
+}
+
+// wrongTypeConverter implements Converter but returns a value of the wrong
+// type, so set() must reject it and fall back to the plain struct copy
+// instead of accepting the mismatch as success.
+type wrongTypeConverter struct {
+	Name string
+}
+
+func (c *wrongTypeConverter) Convert(src interface{}) (interface{}, error) {
+	return 42, nil
+}
+
+func TestSetConverterInterfaceMismatchFallsBackInsteadOfSilentlySucceeding(t *testing.T) {
+	type Inner struct {
+		Name string
+	}
+	type Src struct {
+		Value Inner
+	}
+	type Dst struct {
+		Value wrongTypeConverter
+	}
+
+	src := &Src{Value: Inner{Name: "hello"}}
+	dst := &Dst{}
+	if err := Copy(dst, src); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if dst.Value.Name != "hello" {
+		t.Errorf("Value.Name = %q, want %q via the plain-struct-copy fallback", dst.Value.Name, "hello")
+	}
+}
+
+func TestRegisterConverterErrorPropagates(t *testing.T) {
+	type Src struct {
+		Value string
+	}
+	type Dst struct {
+		Value int
+	}
+
+	wantErr := errors.New("boom")
+	c := NewCopier("", "")
+	c.RegisterConverter(reflect.TypeOf(""), reflect.TypeOf(0), func(src interface{}) (interface{}, error) {
+		return nil, wantErr
+	})
+
+	err := c.Copy(&Dst{}, &Src{Value: "hello"})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Copy() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestCopyDottedPathTagIsCaseInsensitive(t *testing.T) {
+	type Src struct {
+		Name string `copier:"user.profile.name"`
+	}
+	type Profile struct {
+		Name string
+	}
+	type User struct {
+		Profile Profile
+	}
+	type Dst struct {
+		User User
+	}
+
+	src := &Src{Name: "hello"}
+	dst := &Dst{}
+	if err := NewCopier("copier", "copier").Copy(dst, src); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if dst.User.Profile.Name != "hello" {
+		t.Errorf("User.Profile.Name = %q, want %q", dst.User.Profile.Name, "hello")
+	}
+}
+
+func TestCopyDottedPathTagErrorsOnUnresolvedSegment(t *testing.T) {
+	type Src struct {
+		Name string `copier:"user.nickname.name"`
+	}
+	type Profile struct {
+		Name string
+	}
+	type User struct {
+		Profile Profile
+	}
+	type Dst struct {
+		User User
+	}
+
+	err := NewCopier("copier", "copier").Copy(&Dst{}, &Src{Name: "hello"})
+	if err == nil {
+		t.Error("Copy() error = nil, want an error for the unresolved \"nickname\" path segment")
+	}
+}
+
+func TestCopySliceOfMapsToSliceOfStructs(t *testing.T) {
+	type Dest struct {
+		Name string
+	}
+
+	src := []map[string]interface{}{
+		{"Name": "alice"},
+		{"Name": "bob"},
+	}
+	var dst []Dest
+	if err := Copy(&dst, src); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if len(dst) != 2 || dst[0].Name != "alice" || dst[1].Name != "bob" {
+		t.Errorf("dst = %+v, want [{alice} {bob}]", dst)
+	}
+}